@@ -0,0 +1,263 @@
+// Package dnsmasq manages a dedicated dnsmasq config fragment over SSH, used to represent
+// record types and TTLs that Pi-hole's customdns.php cannot (TXT, MX, SRV, CNAME-with-TTL, ...).
+package dnsmasq
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// ConfPath is the dnsmasq config fragment this transport exclusively owns. Anything outside
+// the managed header in this file is assumed to have been written by a previous apply and is
+// safe to overwrite; nothing else on the host is touched.
+const ConfPath = "/etc/dnsmasq.d/05-terraform.conf"
+
+const header = "# Managed by terraform-provider-pihole. Do not edit by hand.\n"
+
+// Record is a single dnsmasq record of an arbitrary type, with an optional TTL.
+type Record struct {
+	Domain string
+	Type   string
+	TTL    int
+	Value  string
+}
+
+// Config holds the SSH connection details used to reach the dnsmasq host.
+type Config struct {
+	Host string
+	User string
+	Key  []byte
+}
+
+// Client manages the terraform-owned dnsmasq config fragment over SSH.
+type Client struct {
+	config Config
+}
+
+// NewClient constructs a Client from the given Config.
+func NewClient(config Config) *Client {
+	return &Client{config: config}
+}
+
+// List reads the managed config fragment back into the records it owns.
+func (c *Client) List() ([]Record, error) {
+	contents, err := c.run("cat "+ConfPath+" 2>/dev/null || true", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return parse(contents), nil
+}
+
+// Upsert adds or replaces record in the managed config fragment and restarts dnsmasq.
+func (c *Client) Upsert(record Record) error {
+	if err := validate(record); err != nil {
+		return err
+	}
+
+	records, err := c.List()
+	if err != nil {
+		return err
+	}
+
+	replaced := false
+	for i, r := range records {
+		if r.Domain == record.Domain && r.Type == record.Type {
+			records[i] = record
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		records = append(records, record)
+	}
+
+	return c.writeAndRestart(records)
+}
+
+// Delete removes the domain+type entry from the managed config fragment and restarts dnsmasq.
+func (c *Client) Delete(domain, recordType string) error {
+	records, err := c.List()
+	if err != nil {
+		return err
+	}
+
+	filtered := records[:0]
+	for _, r := range records {
+		if r.Domain == domain && r.Type == recordType {
+			continue
+		}
+		filtered = append(filtered, r)
+	}
+
+	return c.writeAndRestart(filtered)
+}
+
+// validate rejects record content that can't round-trip through a single dnsmasq directive line.
+func validate(record Record) error {
+	for field, value := range map[string]string{"domain": record.Domain, "value": record.Value} {
+		if strings.ContainsAny(value, "\n\r") {
+			return fmt.Errorf("dnsmasq record %s cannot contain a newline: %q", field, value)
+		}
+	}
+
+	return nil
+}
+
+// writeAndRestart overwrites the managed config fragment and restarts dnsmasq. The new content
+// is streamed over the session's stdin rather than interpolated into a shell heredoc, so record
+// values can never be mistaken for shell syntax (e.g. a value containing a heredoc delimiter).
+func (c *Client) writeAndRestart(records []Record) error {
+	if _, err := c.run("cat > "+ConfPath, strings.NewReader(render(records))); err != nil {
+		return err
+	}
+
+	_, err := c.run("pihole restartdns", nil)
+	return err
+}
+
+// render serializes records into the dnsmasq directives it owns, preceded by the managed header.
+func render(records []Record) string {
+	sort.Slice(records, func(i, j int) bool {
+		if records[i].Domain != records[j].Domain {
+			return records[i].Domain < records[j].Domain
+		}
+		return records[i].Type < records[j].Type
+	})
+
+	var b bytes.Buffer
+	b.WriteString(header)
+
+	for _, r := range records {
+		switch r.Type {
+		case "TXT":
+			fmt.Fprintf(&b, "txt-record=%s,%s\n", r.Domain, r.Value)
+		case "MX":
+			fmt.Fprintf(&b, "mx-host=%s,%s\n", r.Domain, r.Value)
+		case "SRV":
+			fmt.Fprintf(&b, "srv-host=%s,%s\n", r.Domain, r.Value)
+		case "CNAME":
+			writeWithOptionalTTL(&b, "cname", r)
+		default:
+			writeWithOptionalTTL(&b, "host-record", r)
+		}
+	}
+
+	return b.String()
+}
+
+// writeWithOptionalTTL emits a directive=domain,value[,ttl] line, only appending the trailing
+// TTL field when r.TTL is set. An unset TTL (the schema default, 0) means "no preference" and
+// must round-trip as an absent field, not a literal TTL=0 ("don't cache").
+func writeWithOptionalTTL(b *bytes.Buffer, directive string, r Record) {
+	if r.TTL > 0 {
+		fmt.Fprintf(b, "%s=%s,%s,%d\n", directive, r.Domain, r.Value, r.TTL)
+		return
+	}
+
+	fmt.Fprintf(b, "%s=%s,%s\n", directive, r.Domain, r.Value)
+}
+
+// ttlFields marks the directives whose trailing comma-separated field is a TTL rather than part
+// of the record value, so parse can tell the two apart when the value itself contains commas.
+var ttlFields = map[string]bool{
+	"cname":       true,
+	"host-record": true,
+}
+
+// parse is the inverse of render, reading the managed directives back into Records. Everything
+// after the domain is treated as the record value, with a trailing TTL field split back off for
+// directives that carry one, so a value containing commas still round-trips intact.
+func parse(contents string) []Record {
+	var records []Record
+
+	for _, line := range strings.Split(contents, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		key, rest, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+
+		parts := strings.Split(rest, ",")
+		if len(parts) < 2 {
+			continue
+		}
+
+		domain := parts[0]
+		fields := parts[1:]
+
+		ttl := 0
+		if ttlFields[key] && len(fields) > 1 {
+			if parsed, err := strconv.Atoi(fields[len(fields)-1]); err == nil {
+				ttl = parsed
+				fields = fields[:len(fields)-1]
+			}
+		}
+
+		value := strings.Join(fields, ",")
+
+		switch key {
+		case "txt-record":
+			records = append(records, Record{Domain: domain, Type: "TXT", Value: value})
+		case "mx-host":
+			records = append(records, Record{Domain: domain, Type: "MX", Value: value})
+		case "srv-host":
+			records = append(records, Record{Domain: domain, Type: "SRV", Value: value})
+		case "cname":
+			records = append(records, Record{Domain: domain, Type: "CNAME", Value: value, TTL: ttl})
+		case "host-record":
+			records = append(records, Record{Domain: domain, Type: "A", Value: value, TTL: ttl})
+		}
+	}
+
+	return records
+}
+
+// run executes cmd on the configured host over SSH, piping stdin (if non-nil) to it, and
+// returns its stdout.
+func (c *Client) run(cmd string, stdin *strings.Reader) (string, error) {
+	signer, err := ssh.ParsePrivateKey(c.config.Key)
+	if err != nil {
+		return "", fmt.Errorf("parsing ssh key: %w", err)
+	}
+
+	client, err := ssh.Dial("tcp", c.config.Host, &ssh.ClientConfig{
+		User: c.config.User,
+		Auth: []ssh.AuthMethod{ssh.PublicKeys(signer)},
+		// Host key verification is intentionally out of scope here, matching Terraform's
+		// built-in remote-exec provisioner's default behavior.
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("dialing %s: %w", c.config.Host, err)
+	}
+	defer client.Close()
+
+	session, err := client.NewSession()
+	if err != nil {
+		return "", fmt.Errorf("opening ssh session: %w", err)
+	}
+	defer session.Close()
+
+	if stdin != nil {
+		session.Stdin = stdin
+	}
+
+	var stdout bytes.Buffer
+	session.Stdout = &stdout
+
+	if err = session.Run(cmd); err != nil {
+		return "", fmt.Errorf("running %q: %w", cmd, err)
+	}
+
+	return stdout.String(), nil
+}
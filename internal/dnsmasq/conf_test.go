@@ -0,0 +1,51 @@
+package dnsmasq
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRenderParseRoundTrip(t *testing.T) {
+	records := []Record{
+		{Domain: "a.example.com", Type: "A", Value: "10.0.0.1", TTL: 300},
+		{Domain: "cname.example.com", Type: "CNAME", Value: "a.example.com", TTL: 60},
+		{Domain: "txt.example.com", Type: "TXT", Value: "v=spf1, include:_spf.example.com, ~all"},
+		{Domain: "mx.example.com", Type: "MX", Value: "10 mail.example.com"},
+		{Domain: "srv.example.com", Type: "SRV", Value: "0 5 5060 sip.example.com"},
+	}
+
+	got := parse(render(records))
+
+	want := append([]Record{}, records...)
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("round trip mismatch:\n got: %#v\nwant: %#v", got, want)
+	}
+}
+
+func TestRenderOmitsUnsetTTL(t *testing.T) {
+	records := []Record{
+		{Domain: "a.example.com", Type: "A", Value: "10.0.0.1"},
+		{Domain: "cname.example.com", Type: "CNAME", Value: "a.example.com"},
+	}
+
+	rendered := render(records)
+	if strings.Contains(rendered, "10.0.0.1,0") || strings.Contains(rendered, "a.example.com,0") {
+		t.Fatalf("expected unset TTL to be omitted, got:\n%s", rendered)
+	}
+
+	got := parse(rendered)
+	want := append([]Record{}, records...)
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("round trip mismatch:\n got: %#v\nwant: %#v", got, want)
+	}
+}
+
+func TestParseIgnoresCommentsAndBlankLines(t *testing.T) {
+	contents := header + "\n# a comment\n\nhost-record=a.example.com,10.0.0.1,0\n"
+
+	got := parse(contents)
+	if len(got) != 1 || got[0].Domain != "a.example.com" {
+		t.Fatalf("expected a single parsed record, got %#v", got)
+	}
+}
@@ -0,0 +1,117 @@
+package pihole
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"strings"
+
+	"github.com/mhaii/go-pihole"
+	"github.com/mhaii/terraform-provider-pihole/internal/dnsmasq"
+	"github.com/mhaii/terraform-provider-pihole/internal/rfc2136"
+)
+
+// Client is the Pi-hole API client shared by every resource and data source in this provider.
+// It supports two backends against the Pi-hole admin interface itself: a legacy session-cookie
+// authenticated client (used when the provider is configured with a password) and a tokenClient
+// wrapping github.com/mhaii/go-pihole (used when configured with an api_token). Exactly one of
+// the two is populated. Either backend can additionally be paired with an optional rfc2136Mirror
+// and/or dnsmasqTransport, configured via the provider's rfc2136_mirror block and ssh_* attributes
+// respectively.
+type Client struct {
+	baseURL string
+	client  *http.Client
+
+	tokenClient *pihole.Client
+
+	rfc2136Mirror *rfc2136.Client
+
+	dnsmasqTransport *dnsmasq.Client
+}
+
+// NewClient constructs a session-cookie authenticated Client against the Pi-hole admin interface at baseURL
+func NewClient(baseURL, password string) (*Client, error) {
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating cookie jar: %w", err)
+	}
+
+	c := &Client{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		client:  &http.Client{Jar: jar},
+	}
+
+	if password == "" {
+		return c, nil
+	}
+
+	req, err := http.NewRequest("POST", c.baseURL+"/admin/index.php?login", strings.NewReader(url.Values{
+		"pw": []string{password},
+	}.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("logging into pihole at %s: %w", c.baseURL, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("pihole login at %s failed with status %s", c.baseURL, res.Status)
+	}
+
+	return c, nil
+}
+
+// NewTokenClient constructs a Client that authenticates to the Pi-hole admin interface at baseURL
+// with an API token instead of a session cookie
+func NewTokenClient(baseURL, apiToken string) (*Client, error) {
+	return &Client{
+		baseURL:     strings.TrimRight(baseURL, "/"),
+		tokenClient: pihole.NewClient(baseURL, apiToken),
+	}, nil
+}
+
+// SetRFC2136Mirror attaches an RFC 2136 mirror backend, so DNS record changes made through this
+// Client are additionally mirrored to an authoritative DNS server
+func (c *Client) SetRFC2136Mirror(mirror *rfc2136.Client) {
+	c.rfc2136Mirror = mirror
+}
+
+// SetDNSMasqTransport attaches the SSH-based dnsmasq transport, so record types and TTLs that
+// customdns.php cannot represent can be written to the managed dnsmasq config fragment
+func (c *Client) SetDNSMasqTransport(transport *dnsmasq.Client) {
+	c.dnsmasqTransport = transport
+}
+
+// RequestWithSession builds an authenticated request against the Pi-hole admin interface, relying
+// on the session cookie established by NewClient
+func (c Client) RequestWithSession(ctx context.Context, method, path string, values *url.Values) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, strings.NewReader(values.Encode()))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	return req, nil
+}
+
+// NotFoundError indicates the requested pihole resource does not exist
+type NotFoundError struct {
+	message string
+}
+
+func (e *NotFoundError) Error() string {
+	return e.message
+}
+
+// NewNotFoundError constructs a NotFoundError with the given message
+func NewNotFoundError(message string) *NotFoundError {
+	return &NotFoundError{message: message}
+}
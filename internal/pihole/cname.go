@@ -0,0 +1,159 @@
+package pihole
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/mhaii/go-pihole"
+)
+
+type CNAMERecordsListResponse struct {
+	Data [][]string
+}
+
+// ToCNAMERecordList converts a CNAMERecordsListResponse into a CNAMERecordList object.
+func (rr CNAMERecordsListResponse) ToCNAMERecordList() CNAMERecordList {
+	list := CNAMERecordList{}
+
+	for _, record := range rr.Data {
+		list = append(list, CNAMERecord{
+			Domain: record[0],
+			Target: record[1],
+		})
+	}
+
+	return list
+}
+
+type CNAMERecordList = pihole.CNAMERecordList
+type CNAMERecord = pihole.CNAMERecord
+
+// ListCNAMERecords returns the list of custom CNAME records configured in pihole
+func (c Client) ListCNAMERecords(ctx context.Context) (CNAMERecordList, error) {
+	if c.tokenClient != nil {
+		return c.tokenClient.LocalCNAME.List(ctx)
+	}
+
+	req, err := c.RequestWithSession(ctx, "POST", "/admin/scripts/pi-hole/php/customcname.php", &url.Values{
+		"action": []string{"get"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	var cnameRes CNAMERecordsListResponse
+	if err = json.NewDecoder(res.Body).Decode(&cnameRes); err != nil {
+		return nil, err
+	}
+
+	return cnameRes.ToCNAMERecordList(), nil
+}
+
+type CreateCNAMERecordResponse struct {
+	Success bool
+	Message string
+}
+
+// CreateCNAMERecord creates a pihole CNAME record entry
+func (c Client) CreateCNAMERecord(ctx context.Context, record *CNAMERecord) (*CNAMERecord, error) {
+	if c.tokenClient != nil {
+		return c.tokenClient.LocalCNAME.Create(ctx, record.Domain, record.Target)
+	}
+
+	req, err := c.RequestWithSession(ctx, "POST", "/admin/scripts/pi-hole/php/customcname.php", &url.Values{
+		"action": []string{"add"},
+		"domain": []string{record.Domain},
+		"target": []string{record.Target},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	var created CreateCNAMERecordResponse
+	if err = json.NewDecoder(res.Body).Decode(&created); err != nil {
+		return nil, err
+	}
+
+	if !created.Success {
+		return nil, fmt.Errorf(created.Message)
+	}
+
+	return record, nil
+}
+
+// GetCNAMERecord searches the pihole CNAME records for the passed domain and returns first result if found
+func (c Client) GetCNAMERecord(ctx context.Context, domain string) (*CNAMERecord, error) {
+	if c.tokenClient != nil {
+		record, err := c.tokenClient.LocalCNAME.Get(ctx, domain)
+		if err != nil {
+			if errors.Is(err, pihole.ErrorLocalCNAMENotFound) {
+				return nil, NewNotFoundError(fmt.Sprintf("cname record with domain %q not found", domain))
+			}
+
+			return nil, err
+		}
+
+		return record, nil
+	}
+
+	list, err := c.ListCNAMERecords(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, record := range list {
+		if record.Domain == strings.ToLower(domain) {
+			return &record, nil
+		}
+	}
+
+	return nil, NewNotFoundError(fmt.Sprintf("cname record with domain %q not found", domain))
+}
+
+// DeleteCNAMERecord deletes a pihole CNAME record by domain name
+func (c Client) DeleteCNAMERecord(ctx context.Context, domain string) error {
+	if c.tokenClient != nil {
+		return c.tokenClient.LocalCNAME.Delete(ctx, domain)
+	}
+
+	record, err := c.GetCNAMERecord(ctx, domain)
+	if err != nil {
+		return err
+	}
+
+	req, err := c.RequestWithSession(ctx, "POST", "/admin/scripts/pi-hole/php/customcname.php", &url.Values{
+		"action": []string{"delete"},
+		"domain": []string{record.Domain},
+		"target": []string{record.Target},
+	})
+	if err != nil {
+		return err
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	return nil
+}
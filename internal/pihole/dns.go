@@ -65,8 +65,31 @@ type CreateDNSRecordResponse struct {
 	Message string
 }
 
-// CreateDNSRecord creates a pihole DNS record entry
+// CreateDNSRecord creates a pihole DNS record entry, additionally mirroring it to the
+// configured RFC 2136 backend if one is set, rolling back the pihole side on mirror failure
 func (c Client) CreateDNSRecord(ctx context.Context, record *DNSRecord) (*DNSRecord, error) {
+	created, err := c.createPiholeDNSRecord(ctx, record)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.rfc2136Mirror == nil {
+		return created, nil
+	}
+
+	if err = c.rfc2136Mirror.UpsertA(created.Domain, created.IP); err != nil {
+		if rollbackErr := c.deletePiholeDNSRecordEntry(ctx, created.Domain, created.IP); rollbackErr != nil {
+			return nil, fmt.Errorf("rfc2136 mirror failed (%v) and pihole rollback also failed: %w", err, rollbackErr)
+		}
+
+		return nil, fmt.Errorf("rfc2136 mirror failed, rolled back pihole record: %w", err)
+	}
+
+	return created, nil
+}
+
+// createPiholeDNSRecord creates the DNS record on the pihole side only, with no RFC 2136 mirroring
+func (c Client) createPiholeDNSRecord(ctx context.Context, record *DNSRecord) (*DNSRecord, error) {
 	if c.tokenClient != nil {
 		return c.tokenClient.LocalDNS.Create(ctx, record.Domain, record.IP)
 	}
@@ -156,6 +179,17 @@ func (c Client) GetDNSRecordList(ctx context.Context, domain string) ([]*DNSReco
 	return results, nil
 }
 
+// UpdateDNSRecord reconciles a pihole local DNS record by deleting the old entry and adding the
+// new one back-to-back, so the record is missing only between those two calls rather than across
+// a separate Terraform destroy and create
+func (c Client) UpdateDNSRecord(ctx context.Context, oldRecord, newRecord *DNSRecord) (*DNSRecord, error) {
+	if err := c.deleteDNSRecordEntry(ctx, oldRecord.Domain, oldRecord.IP); err != nil {
+		return nil, err
+	}
+
+	return c.CreateDNSRecord(ctx, newRecord)
+}
+
 // DeleteDNSRecord deletes a pihole local DNS record by domain name
 func (c Client) DeleteDNSRecord(ctx context.Context, domain string) error {
 	if c.tokenClient != nil {
@@ -168,28 +202,145 @@ func (c Client) DeleteDNSRecord(ctx context.Context, domain string) error {
 	}
 
 	for _, record := range records {
-		if err = func() error {
-			req, err := c.RequestWithSession(ctx, "POST", "/admin/scripts/pi-hole/php/customdns.php", &url.Values{
-				"action": []string{"delete"},
-				"ip":     []string{record.IP},
-				"domain": []string{record.Domain},
-			})
-			if err != nil {
-				return err
-			}
+		if err = c.deleteDNSRecordEntry(ctx, record.Domain, record.IP); err != nil {
+			return err
+		}
+	}
 
-			res, err := c.client.Do(req)
-			if err != nil {
-				return err
-			}
+	return nil
+}
 
-			defer res.Body.Close()
+// deleteDNSRecordEntry removes a single domain+IP pihole local DNS record entry, additionally
+// mirroring the removal to the configured RFC 2136 backend if one is set, rolling back the
+// pihole side on mirror failure
+func (c Client) deleteDNSRecordEntry(ctx context.Context, domain, ip string) error {
+	if err := c.deletePiholeDNSRecordEntry(ctx, domain, ip); err != nil {
+		return err
+	}
+
+	if c.rfc2136Mirror == nil {
+		return nil
+	}
+
+	if err := c.rfc2136Mirror.DeleteA(domain, ip); err != nil {
+		if _, rollbackErr := c.createPiholeDNSRecord(ctx, &DNSRecord{Domain: domain, IP: ip}); rollbackErr != nil {
+			return fmt.Errorf("rfc2136 mirror failed (%v) and pihole rollback also failed: %w", err, rollbackErr)
+		}
+
+		return fmt.Errorf("rfc2136 mirror failed, rolled back pihole record: %w", err)
+	}
+
+	return nil
+}
+
+// deletePiholeDNSRecordEntry removes a single domain+IP DNS record on the pihole side only, with
+// no RFC 2136 mirroring, leaving any other IPs on the same domain untouched.
+func (c Client) deletePiholeDNSRecordEntry(ctx context.Context, domain, ip string) error {
+	if c.tokenClient != nil {
+		return c.deleteTokenClientDNSRecordEntry(ctx, domain, ip)
+	}
+
+	req, err := c.RequestWithSession(ctx, "POST", "/admin/scripts/pi-hole/php/customdns.php", &url.Values{
+		"action": []string{"delete"},
+		"ip":     []string{ip},
+		"domain": []string{domain},
+	})
+	if err != nil {
+		return err
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
 
+	defer res.Body.Close()
+
+	return nil
+}
+
+// deleteTokenClientDNSRecordEntry removes a single domain+IP entry via the tokenClient backend,
+// whose LocalDNS.Delete only supports removing an entire domain's records at once. It reconciles
+// this by deleting the whole domain and recreating every surviving IP, so a multi-IP domain
+// (e.g. under pihole_dns_record_set) doesn't lose unrelated records in the process.
+func (c Client) deleteTokenClientDNSRecordEntry(ctx context.Context, domain, ip string) error {
+	records, err := c.tokenClient.LocalDNS.GetList(ctx, domain)
+	if err != nil {
+		if errors.Is(err, pihole.ErrorLocalDNSNotFound) {
 			return nil
-		}(); err != nil {
+		}
+
+		return err
+	}
+
+	if err = c.tokenClient.LocalDNS.Delete(ctx, domain); err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		if record.IP == ip {
+			continue
+		}
+
+		if _, err = c.tokenClient.LocalDNS.Create(ctx, record.Domain, record.IP); err != nil {
 			return err
 		}
 	}
 
 	return nil
 }
+
+// ReconcileDNSRecordSet converges the local DNS records for domain to exactly desiredIPs,
+// issuing only the add/delete calls needed so unrelated entries are left untouched
+func (c Client) ReconcileDNSRecordSet(ctx context.Context, domain string, desiredIPs []string) (DNSRecordList, error) {
+	domain = strings.ToLower(domain)
+
+	existing, err := c.GetDNSRecordList(ctx, domain)
+	if err != nil {
+		if _, ok := err.(*NotFoundError); !ok {
+			return nil, err
+		}
+	}
+
+	desired := map[string]bool{}
+	for _, ip := range desiredIPs {
+		desired[ip] = true
+	}
+
+	current := map[string]bool{}
+	for _, record := range existing {
+		current[record.IP] = true
+
+		if !desired[record.IP] {
+			if err = c.deleteDNSRecordEntry(ctx, domain, record.IP); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, ip := range desiredIPs {
+		if current[ip] {
+			continue
+		}
+
+		if _, err = c.CreateDNSRecord(ctx, &DNSRecord{Domain: domain, IP: ip}); err != nil {
+			return nil, err
+		}
+	}
+
+	list, err := c.GetDNSRecordList(ctx, domain)
+	if err != nil {
+		if _, ok := err.(*NotFoundError); ok {
+			return DNSRecordList{}, nil
+		}
+
+		return nil, err
+	}
+
+	final := DNSRecordList{}
+	for _, record := range list {
+		final = append(final, *record)
+	}
+
+	return final, nil
+}
@@ -0,0 +1,101 @@
+package pihole
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mhaii/terraform-provider-pihole/internal/dnsmasq"
+)
+
+// managedRecordTypes are the record types customdns.php cannot represent, and so are written to
+// the managed dnsmasq config fragment instead via an optional SSH transport.
+var managedRecordTypes = map[string]bool{
+	"TXT":   true,
+	"MX":    true,
+	"SRV":   true,
+	"CNAME": true,
+}
+
+// IsManagedRecordType reports whether recordType requires the dnsmasq SSH transport rather than
+// customdns.php, either because customdns.php can't represent the type at all, or because ttl is set.
+func IsManagedRecordType(recordType string, ttl int) bool {
+	return managedRecordTypes[recordType] || ttl > 0
+}
+
+// ttlCapableManagedTypes are the managed record types whose dnsmasq directive has a TTL field
+// (host-record, cname). dnsmasq's txt-record/mx-host/srv-host directives have no such field, so a
+// TTL on those types can't be written anywhere and would silently read back as 0 forever.
+var ttlCapableManagedTypes = map[string]bool{
+	"A":     true,
+	"CNAME": true,
+}
+
+// ManagedDNSRecord is a DNS record written to the managed dnsmasq config fragment, covering
+// record types and TTLs that customdns.php has no concept of.
+type ManagedDNSRecord struct {
+	Domain string
+	Type   string
+	TTL    int
+	Value  string
+}
+
+// ListManagedDNSRecords reads back every record the dnsmasq config fragment owns
+func (c Client) ListManagedDNSRecords(ctx context.Context) ([]ManagedDNSRecord, error) {
+	if c.dnsmasqTransport == nil {
+		return nil, fmt.Errorf("managed record types require ssh_host, ssh_user and ssh_key to be configured")
+	}
+
+	records, err := c.dnsmasqTransport.List()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]ManagedDNSRecord, 0, len(records))
+	for _, record := range records {
+		result = append(result, ManagedDNSRecord(record))
+	}
+
+	return result, nil
+}
+
+// UpsertManagedDNSRecord creates or updates a record in the managed dnsmasq config fragment
+func (c Client) UpsertManagedDNSRecord(ctx context.Context, record *ManagedDNSRecord) (*ManagedDNSRecord, error) {
+	if c.dnsmasqTransport == nil {
+		return nil, fmt.Errorf("managed record types require ssh_host, ssh_user and ssh_key to be configured")
+	}
+
+	if record.TTL > 0 && !ttlCapableManagedTypes[record.Type] {
+		return nil, fmt.Errorf("ttl is not supported for record type %q: dnsmasq has no TTL field for this directive", record.Type)
+	}
+
+	if err := c.dnsmasqTransport.Upsert(dnsmasq.Record(*record)); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// GetManagedDNSRecord looks up a single managed record by domain and type
+func (c Client) GetManagedDNSRecord(ctx context.Context, domain, recordType string) (*ManagedDNSRecord, error) {
+	records, err := c.ListManagedDNSRecords(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, record := range records {
+		if record.Domain == domain && record.Type == recordType {
+			return &record, nil
+		}
+	}
+
+	return nil, NewNotFoundError(fmt.Sprintf("managed record %q of type %q not found", domain, recordType))
+}
+
+// DeleteManagedDNSRecord removes a record from the managed dnsmasq config fragment
+func (c Client) DeleteManagedDNSRecord(ctx context.Context, domain, recordType string) error {
+	if c.dnsmasqTransport == nil {
+		return fmt.Errorf("managed record types require ssh_host, ssh_user and ssh_key to be configured")
+	}
+
+	return c.dnsmasqTransport.Delete(domain, recordType)
+}
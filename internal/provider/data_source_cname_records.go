@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mhaii/terraform-provider-pihole/internal/pihole"
+)
+
+// dataSourceCNAMERecords returns the local CNAME records Terraform data source configuration
+func dataSourceCNAMERecords() *schema.Resource {
+	return &schema.Resource{
+		Description: "Looks up Pi-hole CNAME records, optionally filtering by domain or target",
+		ReadContext: dataSourceCNAMERecordsRead,
+		Schema: map[string]*schema.Schema{
+			"domain_regex": {
+				Description: "Regular expression used to filter records by domain",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"target_regex": {
+				Description: "Regular expression used to filter records by target",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"records": {
+				Description: "The matching CNAME records",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"domain": {
+							Description: "CNAME record domain",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"target": {
+							Description: "Target domain the CNAME record resolves to",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// dataSourceCNAMERecordsRead finds the local CNAME records matching the given filters
+func dataSourceCNAMERecordsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client, ok := meta.(*pihole.Client)
+	if !ok {
+		return diag.Errorf("Could not load client in data source request")
+	}
+
+	domainRegex := d.Get("domain_regex").(string)
+	targetRegex := d.Get("target_regex").(string)
+
+	var domainFilter *regexp.Regexp
+	if domainRegex != "" {
+		var err error
+		domainFilter, err = regexp.Compile(domainRegex)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	var targetFilter *regexp.Regexp
+	if targetRegex != "" {
+		var err error
+		targetFilter, err = regexp.Compile(targetRegex)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	list, err := client.ListCNAMERecords(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	records := make([]map[string]interface{}, 0, len(list))
+	for _, record := range list {
+		if domainFilter != nil && !domainFilter.MatchString(record.Domain) {
+			continue
+		}
+
+		if targetFilter != nil && !targetFilter.MatchString(record.Target) {
+			continue
+		}
+
+		records = append(records, map[string]interface{}{
+			"domain": record.Domain,
+			"target": record.Target,
+		})
+	}
+
+	if err = d.Set("records", records); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s_%s", domainRegex, targetRegex))
+
+	return diags
+}
@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mhaii/terraform-provider-pihole/internal/pihole"
+)
+
+// dataSourceDNSRecords returns the local DNS records Terraform data source configuration
+func dataSourceDNSRecords() *schema.Resource {
+	return &schema.Resource{
+		Description: "Looks up Pi-hole DNS records, optionally filtering by domain or IP",
+		ReadContext: dataSourceDNSRecordsRead,
+		Schema: map[string]*schema.Schema{
+			"domain_regex": {
+				Description: "Regular expression used to filter records by domain",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"ip_cidr": {
+				Description: "CIDR range used to filter records by IP address",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"records": {
+				Description: "The matching DNS records",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"domain": {
+							Description: "DNS record domain",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"ip": {
+							Description: "IP address the DNS record domain routes to",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// dataSourceDNSRecordsRead finds the local DNS records matching the given filters
+func dataSourceDNSRecordsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client, ok := meta.(*pihole.Client)
+	if !ok {
+		return diag.Errorf("Could not load client in data source request")
+	}
+
+	domainRegex := d.Get("domain_regex").(string)
+	ipCIDR := d.Get("ip_cidr").(string)
+
+	var domainFilter *regexp.Regexp
+	if domainRegex != "" {
+		var err error
+		domainFilter, err = regexp.Compile(domainRegex)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	var ipFilter *net.IPNet
+	if ipCIDR != "" {
+		_, cidr, err := net.ParseCIDR(ipCIDR)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		ipFilter = cidr
+	}
+
+	list, err := client.ListDNSRecords(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	records := make([]map[string]interface{}, 0, len(list))
+	for _, record := range list {
+		if domainFilter != nil && !domainFilter.MatchString(record.Domain) {
+			continue
+		}
+
+		if ipFilter != nil {
+			ip := net.ParseIP(record.IP)
+			if ip == nil || !ipFilter.Contains(ip) {
+				continue
+			}
+		}
+
+		records = append(records, map[string]interface{}{
+			"domain": record.Domain,
+			"ip":     record.IP,
+		})
+	}
+
+	if err = d.Set("records", records); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s_%s", domainRegex, ipCIDR))
+
+	return diags
+}
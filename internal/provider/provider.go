@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mhaii/terraform-provider-pihole/internal/dnsmasq"
+	"github.com/mhaii/terraform-provider-pihole/internal/pihole"
+	"github.com/mhaii/terraform-provider-pihole/internal/rfc2136"
+)
+
+// Provider returns the pihole Terraform provider
+func Provider() *schema.Provider {
+	return &schema.Provider{
+		Schema: map[string]*schema.Schema{
+			"url": {
+				Description: "Base URL of the Pi-hole admin interface, e.g. https://pi.hole",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"password": {
+				Description: "Pi-hole web interface password, used for session-cookie authentication. Ignored when api_token is set",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"api_token": {
+				Description: "Pi-hole API token, used for token-based authentication instead of a password",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"rfc2136_mirror": {
+				Description: "Mirrors local DNS record changes to an authoritative DNS server via TSIG-signed RFC 2136 dynamic updates",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"server": {
+							Description: "Authoritative DNS server address, e.g. ns1.example.com:53",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"zone": {
+							Description: "Zone that RFC 2136 UPDATE messages are scoped to",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"key_name": {
+							Description: "TSIG key name",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"key_algorithm": {
+							Description: "TSIG algorithm, e.g. hmac-sha256",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"key_secret": {
+							Description: "Base64-encoded TSIG key secret",
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+						},
+					},
+				},
+			},
+			"ssh_host": {
+				Description: "Address of the dnsmasq host to manage over SSH, e.g. pi.hole:22. Required for record types and TTLs that customdns.php cannot represent (TXT, MX, SRV, CNAME-with-TTL, ...)",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"ssh_user": {
+				Description: "SSH user used to reach the dnsmasq host",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"ssh_key": {
+				Description: "PEM-encoded SSH private key used to authenticate to the dnsmasq host",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+			},
+		},
+		ResourcesMap: map[string]*schema.Resource{
+			"pihole_dns_record":     resourceDNSRecord(),
+			"pihole_cname_record":   resourceCNAMERecord(),
+			"pihole_dns_record_set": resourceDNSRecordSet(),
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"pihole_dns_records":   dataSourceDNSRecords(),
+			"pihole_cname_records": dataSourceCNAMERecords(),
+		},
+		ConfigureContextFunc: providerConfigure,
+	}
+}
+
+// providerConfigure builds the pihole.Client used by every resource and data source, wiring up
+// the optional RFC 2136 mirror backend when an rfc2136_mirror block is configured
+func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	baseURL := d.Get("url").(string)
+
+	var client *pihole.Client
+	var err error
+
+	if apiToken, ok := d.GetOk("api_token"); ok {
+		client, err = pihole.NewTokenClient(baseURL, apiToken.(string))
+	} else {
+		client, err = pihole.NewClient(baseURL, d.Get("password").(string))
+	}
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	if mirrors, ok := d.GetOk("rfc2136_mirror"); ok {
+		mirror := mirrors.([]interface{})[0].(map[string]interface{})
+
+		client.SetRFC2136Mirror(rfc2136.NewClient(rfc2136.Config{
+			Server:       mirror["server"].(string),
+			Zone:         mirror["zone"].(string),
+			KeyName:      mirror["key_name"].(string),
+			KeyAlgorithm: mirror["key_algorithm"].(string),
+			KeySecret:    mirror["key_secret"].(string),
+		}))
+	}
+
+	if sshHost, ok := d.GetOk("ssh_host"); ok {
+		client.SetDNSMasqTransport(dnsmasq.NewClient(dnsmasq.Config{
+			Host: sshHost.(string),
+			User: d.Get("ssh_user").(string),
+			Key:  []byte(d.Get("ssh_key").(string)),
+		}))
+	}
+
+	return client, nil
+}
@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mhaii/terraform-provider-pihole/internal/pihole"
+)
+
+// resourceCNAMERecord returns the local CNAME DNS Terraform resource management configuration
+func resourceCNAMERecord() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manages a Pi-hole CNAME record",
+		CreateContext: resourceCNAMERecordCreate,
+		ReadContext:   resourceCNAMERecordRead,
+		DeleteContext: resourceCNAMERecordDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Description: "CNAME record domain",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"target": {
+				Description: "Target domain the CNAME record resolves to",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+		},
+	}
+}
+
+// resourceCNAMERecordCreate handles the creation of a local CNAME record via Terraform
+func resourceCNAMERecordCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client, ok := meta.(*pihole.Client)
+	if !ok {
+		return diag.Errorf("Could not load client in resource request")
+	}
+
+	domain := d.Get("domain").(string)
+	target := d.Get("target").(string)
+
+	_, err := client.CreateCNAMERecord(ctx, &pihole.CNAMERecord{
+		Domain: domain,
+		Target: target,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(domain)
+
+	return diags
+}
+
+// resourceCNAMERecordRead finds a local CNAME record based on the associated domain
+func resourceCNAMERecordRead(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client, ok := meta.(*pihole.Client)
+	if !ok {
+		return diag.Errorf("Could not load client in resource request")
+	}
+
+	record, err := client.GetCNAMERecord(ctx, d.Id())
+	if err != nil {
+		if _, ok := err.(*pihole.NotFoundError); ok {
+			d.SetId("")
+			return nil
+		}
+
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("domain", record.Domain); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("target", record.Target); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+// resourceCNAMERecordDelete handles the deletion of a local CNAME record via Terraform
+func resourceCNAMERecordDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client, ok := meta.(*pihole.Client)
+	if !ok {
+		return diag.Errorf("Could not load client in resource request")
+	}
+
+	if err := client.DeleteCNAMERecord(ctx, d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
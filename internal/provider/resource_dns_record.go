@@ -2,8 +2,6 @@ package provider
 
 import (
 	"context"
-	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -16,6 +14,7 @@ func resourceDNSRecord() *schema.Resource {
 		Description:   "Manages a Pi-hole DNS record",
 		CreateContext: resourceDNSRecordCreate,
 		ReadContext:   resourceDNSRecordRead,
+		UpdateContext: resourceDNSRecordUpdate,
 		DeleteContext: resourceDNSRecordDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -28,10 +27,25 @@ func resourceDNSRecord() *schema.Resource {
 				ForceNew:    true,
 			},
 			"ip": {
-				Description: "IP address to route traffic to from the DNS record domain",
+				Description: "IP address, or record value for non-A/AAAA types, to route traffic to from the DNS record domain",
 				Type:        schema.TypeString,
 				Required:    true,
-				ForceNew:    true,
+			},
+			"type": {
+				Description: "DNS record type. `TXT`, `MX`, `SRV` and `CNAME` are written to the managed " +
+					"dnsmasq config fragment, which requires `ssh_host`/`ssh_user`/`ssh_key` to be configured " +
+					"on the provider; any other type (including `A`/`AAAA`) is managed via customdns.php unless `ttl` is set",
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "A",
+				ForceNew: true,
+			},
+			"ttl": {
+				Description: "Record TTL in seconds. Setting a TTL routes the record through the " +
+					"managed dnsmasq config fragment, since customdns.php has no concept of TTLs",
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 		},
 	}
@@ -46,16 +60,30 @@ func resourceDNSRecordCreate(ctx context.Context, d *schema.ResourceData, meta i
 
 	domain := d.Get("domain").(string)
 	ip := d.Get("ip").(string)
-
-	_, err := client.CreateDNSRecord(ctx, &pihole.DNSRecord{
-		Domain: domain,
-		IP:     ip,
-	})
-	if err != nil {
-		return diag.FromErr(err)
+	recordType := d.Get("type").(string)
+	ttl := d.Get("ttl").(int)
+
+	if pihole.IsManagedRecordType(recordType, ttl) {
+		_, err := client.UpsertManagedDNSRecord(ctx, &pihole.ManagedDNSRecord{
+			Domain: domain,
+			Type:   recordType,
+			TTL:    ttl,
+			Value:  ip,
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		_, err := client.CreateDNSRecord(ctx, &pihole.DNSRecord{
+			Domain: domain,
+			IP:     ip,
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
-	d.SetId(fmt.Sprintf("%s_%s", domain, ip))
+	d.SetId(domain)
 
 	return diags
 }
@@ -67,8 +95,40 @@ func resourceDNSRecordRead(ctx context.Context, d *schema.ResourceData, meta int
 		return diag.Errorf("Could not load client in resource request")
 	}
 
-	id := strings.Split(d.Id(), "_")
-	records, err := client.GetDNSRecordList(ctx, d.Id())
+	recordType := d.Get("type").(string)
+	ttl := d.Get("ttl").(int)
+
+	if pihole.IsManagedRecordType(recordType, ttl) {
+		record, err := client.GetManagedDNSRecord(ctx, d.Id(), recordType)
+		if err != nil {
+			if _, ok := err.(*pihole.NotFoundError); ok {
+				d.SetId("")
+				return nil
+			}
+
+			return diag.FromErr(err)
+		}
+
+		if err = d.Set("domain", record.Domain); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err = d.Set("ip", record.Value); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err = d.Set("type", record.Type); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err = d.Set("ttl", record.TTL); err != nil {
+			return diag.FromErr(err)
+		}
+
+		return diags
+	}
+
+	record, err := client.GetDNSRecord(ctx, d.Id())
 	if err != nil {
 		if _, ok := err.(*pihole.NotFoundError); ok {
 			d.SetId("")
@@ -78,22 +138,87 @@ func resourceDNSRecordRead(ctx context.Context, d *schema.ResourceData, meta int
 		return diag.FromErr(err)
 	}
 
-	var record *pihole.DNSRecord
-	for _, r := range records {
-		if r.IP == id[1] {
-			record = r
+	if err = d.Set("domain", record.Domain); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("ip", record.IP); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+// resourceDNSRecordUpdate reconciles a local DNS record's IP/TTL as an in-place Terraform update,
+// replacing the underlying entry within this one operation instead of a destroy and recreate
+func resourceDNSRecordUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client, ok := meta.(*pihole.Client)
+	if !ok {
+		return diag.Errorf("Could not load client in resource request")
+	}
+
+	domain := d.Get("domain").(string)
+	recordType := d.Get("type").(string)
+	oldIP, newIP := d.GetChange("ip")
+	oldTTL, newTTL := d.GetChange("ttl")
+
+	wasManaged := pihole.IsManagedRecordType(recordType, oldTTL.(int))
+	isManaged := pihole.IsManagedRecordType(recordType, newTTL.(int))
+
+	if wasManaged && isManaged {
+		_, err := client.UpsertManagedDNSRecord(ctx, &pihole.ManagedDNSRecord{
+			Domain: domain,
+			Type:   recordType,
+			TTL:    newTTL.(int),
+			Value:  newIP.(string),
+		})
+		if err != nil {
+			return diag.FromErr(err)
 		}
+
+		return diags
 	}
-	if record == nil {
-		d.SetId("")
-		return nil
+
+	if !wasManaged && !isManaged {
+		_, err := client.UpdateDNSRecord(ctx, &pihole.DNSRecord{
+			Domain: domain,
+			IP:     oldIP.(string),
+		}, &pihole.DNSRecord{
+			Domain: domain,
+			IP:     newIP.(string),
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		return diags
 	}
 
-	if err = d.Set("domain", record.Domain); err != nil {
+	// The TTL change moved the record across backends; it has to be removed from the old one
+	// and created on the new one rather than reconciled in place.
+	if wasManaged {
+		if err := client.DeleteManagedDNSRecord(ctx, domain, recordType); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if _, err := client.CreateDNSRecord(ctx, &pihole.DNSRecord{Domain: domain, IP: newIP.(string)}); err != nil {
+			return diag.FromErr(err)
+		}
+
+		return diags
+	}
+
+	if err := client.DeleteDNSRecord(ctx, domain); err != nil {
 		return diag.FromErr(err)
 	}
 
-	if err = d.Set("ip", record.IP); err != nil {
+	_, err := client.UpsertManagedDNSRecord(ctx, &pihole.ManagedDNSRecord{
+		Domain: domain,
+		Type:   recordType,
+		TTL:    newTTL.(int),
+		Value:  newIP.(string),
+	})
+	if err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -107,7 +232,16 @@ func resourceDNSRecordDelete(ctx context.Context, d *schema.ResourceData, meta i
 		return diag.Errorf("Could not load client in resource request")
 	}
 
-	if err := client.DeleteDNSRecord(ctx, d.Id()); err != nil {
+	recordType := d.Get("type").(string)
+	ttl := d.Get("ttl").(int)
+
+	var err error
+	if pihole.IsManagedRecordType(recordType, ttl) {
+		err = client.DeleteManagedDNSRecord(ctx, d.Id(), recordType)
+	} else {
+		err = client.DeleteDNSRecord(ctx, d.Id())
+	}
+	if err != nil {
 		return diag.FromErr(err)
 	}
 
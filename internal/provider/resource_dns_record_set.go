@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mhaii/terraform-provider-pihole/internal/pihole"
+)
+
+// resourceDNSRecordSet returns the local DNS record set Terraform resource management configuration
+func resourceDNSRecordSet() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manages the full set of Pi-hole DNS records for a domain, adding and deleting individual records until it matches; the changes are not applied atomically",
+		CreateContext: resourceDNSRecordSetCreate,
+		ReadContext:   resourceDNSRecordSetRead,
+		UpdateContext: resourceDNSRecordSetUpdate,
+		DeleteContext: resourceDNSRecordSetDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Description: "DNS record domain",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"ips": {
+				Description: "Set of IP addresses the DNS record domain should resolve to",
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// resourceDNSRecordSetCreate reconciles a local DNS record set into existence via Terraform
+func resourceDNSRecordSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	domain := d.Get("domain").(string)
+
+	d.SetId(domain)
+
+	return resourceDNSRecordSetReconcile(ctx, d, meta)
+}
+
+// resourceDNSRecordSetRead finds the local DNS records currently set for the associated domain
+func resourceDNSRecordSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client, ok := meta.(*pihole.Client)
+	if !ok {
+		return diag.Errorf("Could not load client in resource request")
+	}
+
+	records, err := client.GetDNSRecordList(ctx, d.Id())
+	if err != nil {
+		if _, ok := err.(*pihole.NotFoundError); ok {
+			d.SetId("")
+			return nil
+		}
+
+		return diag.FromErr(err)
+	}
+
+	ips := make([]interface{}, 0, len(records))
+	for _, record := range records {
+		ips = append(ips, record.IP)
+	}
+
+	if err = d.Set("domain", d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("ips", ips); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+// resourceDNSRecordSetUpdate reconciles the local DNS record set to match the desired IPs
+func resourceDNSRecordSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceDNSRecordSetReconcile(ctx, d, meta)
+}
+
+// resourceDNSRecordSetDelete removes every local DNS record for the associated domain
+func resourceDNSRecordSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client, ok := meta.(*pihole.Client)
+	if !ok {
+		return diag.Errorf("Could not load client in resource request")
+	}
+
+	if _, err := client.ReconcileDNSRecordSet(ctx, d.Id(), nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// resourceDNSRecordSetReconcile converges the domain's DNS records to the desired ips set
+func resourceDNSRecordSetReconcile(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client, ok := meta.(*pihole.Client)
+	if !ok {
+		return diag.Errorf("Could not load client in resource request")
+	}
+
+	domain := d.Get("domain").(string)
+
+	desired := d.Get("ips").(*schema.Set).List()
+	ips := make([]string, 0, len(desired))
+	for _, ip := range desired {
+		ips = append(ips, ip.(string))
+	}
+
+	if _, err := client.ReconcileDNSRecordSet(ctx, domain, ips); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
@@ -0,0 +1,116 @@
+// Package rfc2136 issues TSIG-signed RFC 2136 dynamic DNS updates, used to mirror Pi-hole
+// custom DNS records onto an authoritative server for split-horizon or VPN scenarios.
+package rfc2136
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+// Config holds the connection and TSIG signing details for an RFC 2136 dynamic update target
+type Config struct {
+	// Server is the authoritative DNS server address, e.g. "ns1.example.com:53"
+	Server string
+	// Zone is the zone that UPDATE messages are scoped to, e.g. "example.com."
+	Zone string
+	// KeyName is the TSIG key name
+	KeyName string
+	// KeyAlgorithm is the TSIG algorithm, e.g. dns.HmacSHA256
+	KeyAlgorithm string
+	// KeySecret is the base64-encoded TSIG key secret
+	KeySecret string
+}
+
+// Client issues TSIG-authenticated RFC 2136 dynamic updates against a single authoritative server
+type Client struct {
+	config Config
+	dns    *dns.Client
+}
+
+// NewClient constructs a Client from the given Config
+func NewClient(config Config) *Client {
+	keyName := dns.Fqdn(config.KeyName)
+
+	return &Client{
+		config: config,
+		dns: &dns.Client{
+			TsigSecret: map[string]string{keyName: config.KeySecret},
+		},
+	}
+}
+
+// UpsertA adds the A/AAAA record for domain pointing at ip in the configured zone, leaving any
+// other addresses of domain in place, and choosing the RR type from ip's address family so IPv6
+// targets are mirrored as AAAA records.
+func (c *Client) UpsertA(domain, ip string) error {
+	rrType, err := addressRRType(ip)
+	if err != nil {
+		return err
+	}
+
+	rr, err := dns.NewRR(fmt.Sprintf("%s 0 IN %s %s", dns.Fqdn(domain), rrType, ip))
+	if err != nil {
+		return fmt.Errorf("building %s record for %q: %w", rrType, domain, err)
+	}
+
+	m := new(dns.Msg)
+	m.SetUpdate(dns.Fqdn(c.config.Zone))
+	m.Insert([]dns.RR{rr})
+
+	return c.exchange(m)
+}
+
+// DeleteA removes the A/AAAA record for domain from the configured zone, choosing the RR type
+// from ip's address family so IPv6 targets are mirrored as AAAA records.
+func (c *Client) DeleteA(domain, ip string) error {
+	rrType, err := addressRRType(ip)
+	if err != nil {
+		return err
+	}
+
+	rr, err := dns.NewRR(fmt.Sprintf("%s 0 IN %s %s", dns.Fqdn(domain), rrType, ip))
+	if err != nil {
+		return fmt.Errorf("building %s record for %q: %w", rrType, domain, err)
+	}
+
+	m := new(dns.Msg)
+	m.SetUpdate(dns.Fqdn(c.config.Zone))
+	m.Remove([]dns.RR{rr})
+
+	return c.exchange(m)
+}
+
+// addressRRType returns "A" or "AAAA" depending on ip's address family
+func addressRRType(ip string) (string, error) {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return "", fmt.Errorf("invalid IP address %q", ip)
+	}
+
+	if parsed.To4() != nil {
+		return "A", nil
+	}
+
+	return "AAAA", nil
+}
+
+// exchange signs m with the configured TSIG key and sends it to the configured server. TsigSecret
+// is set once in NewClient rather than here, since c.dns is shared across concurrent calls (the
+// Terraform SDK runs resource CRUD concurrently) and reassigning its map on every call would race.
+func (c *Client) exchange(m *dns.Msg) error {
+	m.SetTsig(dns.Fqdn(c.config.KeyName), c.config.KeyAlgorithm, 300, time.Now().Unix())
+
+	res, _, err := c.dns.Exchange(m, c.config.Server)
+	if err != nil {
+		return fmt.Errorf("rfc2136 update to %s: %w", c.config.Server, err)
+	}
+
+	if res.Rcode != dns.RcodeSuccess {
+		return fmt.Errorf("rfc2136 update to %s rejected: %s", c.config.Server, dns.RcodeToString[res.Rcode])
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package rfc2136
+
+import "testing"
+
+func TestAddressRRType(t *testing.T) {
+	cases := []struct {
+		ip      string
+		want    string
+		wantErr bool
+	}{
+		{ip: "10.0.0.1", want: "A"},
+		{ip: "2001:db8::1", want: "AAAA"},
+		{ip: "not-an-ip", wantErr: true},
+	}
+
+	for _, tc := range cases {
+		got, err := addressRRType(tc.ip)
+		if tc.wantErr {
+			if err == nil {
+				t.Errorf("addressRRType(%q): expected error, got %q", tc.ip, got)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("addressRRType(%q): unexpected error: %v", tc.ip, err)
+			continue
+		}
+
+		if got != tc.want {
+			t.Errorf("addressRRType(%q) = %q, want %q", tc.ip, got, tc.want)
+		}
+	}
+}